@@ -0,0 +1,33 @@
+package config
+
+import (
+    "context"
+    "fmt"
+    "os"
+    "reflect"
+)
+
+// dotEnvProvider is the Provider returned by FromDotEnv.
+type dotEnvProvider struct {
+    path string
+}
+
+// FromDotEnv returns a Provider that loads key=value pairs from the .env file
+// at path into the process environment (without overriding variables already
+// set), so a later FromEnv provider in the same pipeline can read them. A
+// missing file is not an error; it is reported as a warning so the pipeline
+// can still fall back to system environment variables.
+func FromDotEnv(path string) Provider {
+    return &dotEnvProvider{path: path}
+}
+
+func (p *dotEnvProvider) Fill(ctx context.Context, structVal reflect.Value) error {
+    if err := loadEnvFile(p.path); err != nil {
+        if os.IsNotExist(err) {
+            fmt.Printf("Warning: No .env file loaded, falling back to system environment variables: %v\n", err)
+            return nil
+        }
+        return fmt.Errorf("loading dotenv file %s: %w", p.path, err)
+    }
+    return nil
+}
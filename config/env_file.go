@@ -0,0 +1,128 @@
+package config
+
+import (
+    "bufio"
+    "fmt"
+    "os"
+    "strings"
+)
+
+// ExpandAll, when true, expands ${VAR} / $VAR references in every field's
+// value, equivalent to tagging every field with envExpand:"true".
+var ExpandAll bool
+
+// loadEnvFile reads a .env file and sets process environment variables for
+// any key not already set, so system environment variables always take
+// precedence over the file. Values are stored unexpanded; expansion happens
+// lazily in assignField so it can be gated per field via envExpand.
+func loadEnvFile(filePath string) error {
+    file, err := os.Open(filePath)
+    if err != nil {
+        return err // Return error if file can't be opened (e.g., doesn't exist)
+    }
+    defer file.Close()
+
+    scanner := bufio.NewScanner(file)
+    for scanner.Scan() {
+        line := strings.TrimSpace(scanner.Text())
+        if line == "" || strings.HasPrefix(line, "#") {
+            continue // Skip empty lines and comments
+        }
+
+        parts := strings.SplitN(line, "=", 2)
+        if len(parts) != 2 {
+            continue // Skip malformed lines
+        }
+
+        key := strings.TrimSpace(parts[0])
+        value := strings.TrimSpace(parts[1])
+
+        if os.Getenv(key) == "" {
+            if err := os.Setenv(key, value); err != nil {
+                return fmt.Errorf("failed to set env var %s: %v", key, err)
+            }
+        }
+    }
+
+    return scanner.Err()
+}
+
+// expandValue resolves ${VAR} and $VAR references in value against the
+// process environment (which by this point includes anything loadEnvFile
+// loaded), escaping "$$" to a literal "$". seen tracks the chain of variable
+// names expanded so far so that cyclic references return an error instead of
+// recursing forever.
+func expandValue(value string, seen map[string]bool) (string, error) {
+    var sb strings.Builder
+
+    for i := 0; i < len(value); i++ {
+        c := value[i]
+        if c != '$' {
+            sb.WriteByte(c)
+            continue
+        }
+
+        if i+1 < len(value) && value[i+1] == '$' {
+            sb.WriteByte('$')
+            i++
+            continue
+        }
+
+        name, length := readVarName(value[i+1:])
+        if name == "" {
+            sb.WriteByte(c)
+            continue
+        }
+
+        if seen[name] {
+            return "", fmt.Errorf("cyclic reference detected for %s", name)
+        }
+
+        if refVal := os.Getenv(name); refVal != "" {
+            nextSeen := make(map[string]bool, len(seen)+1)
+            for k := range seen {
+                nextSeen[k] = true
+            }
+            nextSeen[name] = true
+
+            expanded, err := expandValue(refVal, nextSeen)
+            if err != nil {
+                return "", err
+            }
+            sb.WriteString(expanded)
+        }
+
+        i += length
+    }
+
+    return sb.String(), nil
+}
+
+// readVarName parses a ${VAR} or $VAR reference at the start of s and returns
+// the variable name along with the number of characters it consumed from s.
+func readVarName(s string) (name string, length int) {
+    if s == "" {
+        return "", 0
+    }
+
+    if s[0] == '{' {
+        end := strings.IndexByte(s, '}')
+        if end < 0 {
+            return "", 0
+        }
+        return s[1:end], end + 1
+    }
+
+    end := 0
+    for end < len(s) && isVarNameByte(s[end]) {
+        end++
+    }
+    return s[:end], end
+}
+
+func isVarNameByte(b byte) bool {
+    return b == '_' ||
+        (b >= 'a' && b <= 'z') ||
+        (b >= 'A' && b <= 'Z') ||
+        (b >= '0' && b <= '9')
+}
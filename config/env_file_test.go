@@ -0,0 +1,81 @@
+package config
+
+import (
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func writeEnvFile(t *testing.T, contents string) string {
+    t.Helper()
+    path := filepath.Join(t.TempDir(), ".env")
+    if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+        t.Fatalf("writing test .env file: %v", err)
+    }
+    return path
+}
+
+func TestFromDotEnv_ExpandsAcrossFileAndSystemEnv(t *testing.T) {
+    t.Setenv("TEST_SYS_VAR", "sysval")
+
+    path := writeEnvFile(t, ""+
+        "TEST_FOO=bar\n"+
+        "TEST_BAZ=${TEST_FOO}/baz\n"+
+        "TEST_COMBINED=${TEST_SYS_VAR}-${TEST_BAZ}\n",
+    )
+
+    type cfg struct {
+        Baz      string `env:"TEST_BAZ" envExpand:"true"`
+        Combined string `env:"TEST_COMBINED" envExpand:"true"`
+    }
+
+    var c cfg
+    if err := Load(&c, FromDotEnv(path), FromEnv()); err != nil {
+        t.Fatalf("Load returned unexpected error: %v", err)
+    }
+
+    if c.Baz != "bar/baz" {
+        t.Errorf("Baz = %q, want %q", c.Baz, "bar/baz")
+    }
+    if c.Combined != "sysval-bar/baz" {
+        t.Errorf("Combined = %q, want %q", c.Combined, "sysval-bar/baz")
+    }
+}
+
+func TestFromDotEnv_LiteralDollarEscape(t *testing.T) {
+    path := writeEnvFile(t, "TEST_LITERAL=$$HOME/tmp\n")
+
+    type cfg struct {
+        Literal string `env:"TEST_LITERAL" envExpand:"true"`
+    }
+
+    var c cfg
+    if err := Load(&c, FromDotEnv(path), FromEnv()); err != nil {
+        t.Fatalf("Load returned unexpected error: %v", err)
+    }
+
+    if c.Literal != "$HOME/tmp" {
+        t.Errorf("Literal = %q, want %q", c.Literal, "$HOME/tmp")
+    }
+}
+
+func TestFromDotEnv_CyclicReferenceIsRejected(t *testing.T) {
+    path := writeEnvFile(t, ""+
+        "TEST_CYCLE_A=${TEST_CYCLE_B}\n"+
+        "TEST_CYCLE_B=${TEST_CYCLE_A}\n",
+    )
+
+    type cfg struct {
+        A string `env:"TEST_CYCLE_A" envExpand:"true"`
+    }
+
+    var c cfg
+    err := Load(&c, FromDotEnv(path), FromEnv())
+    if err == nil {
+        t.Fatal("expected an error for a cyclic reference, got nil")
+    }
+    if !strings.Contains(err.Error(), "cyclic") {
+        t.Errorf("expected error to mention a cyclic reference, got: %v", err)
+    }
+}
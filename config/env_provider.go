@@ -0,0 +1,268 @@
+package config
+
+import (
+    "context"
+    "fmt"
+    "os"
+    "reflect"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// durationType identifies int64 fields that should be parsed with
+// time.ParseDuration instead of as a plain integer, e.g. `env:"TIMEOUT"` on a
+// time.Duration field set to "3m".
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// envProvider is the Provider returned by FromEnv; it reads struct fields
+// from their `env` tags (optionally prefixed) directly out of the process
+// environment.
+type envProvider struct {
+    prefix string
+}
+
+// EnvOption configures a Provider returned by FromEnv.
+type EnvOption func(*envProvider)
+
+// WithPrefix prepends prefix to every environment variable key FromEnv looks
+// up, e.g. WithPrefix("APP_") turns `env:"MONGO_HOST"` into APP_MONGO_HOST.
+func WithPrefix(prefix string) EnvOption {
+    return func(p *envProvider) { p.prefix = prefix }
+}
+
+// FromEnv returns a Provider that populates struct fields from their `env`
+// struct tags, reading envDefault, required, unset, envSeparator,
+// envKeyValSeparator, and envExpand. Fields with no `env` tag are looked up
+// under their snake_case+UPPER name (MongoDBHost -> MONGO_DB_HOST). It is the
+// default provider InitializeConfig composes.
+func FromEnv(opts ...EnvOption) Provider {
+    p := &envProvider{}
+    for _, opt := range opts {
+        opt(p)
+    }
+    return p
+}
+
+func (p *envProvider) Fill(ctx context.Context, structVal reflect.Value) error {
+    return fillEnvFields(structVal, p.prefix)
+}
+
+// envPrefix reports the prefix this provider prepends to every env key, so
+// Load can report required-field errors using the key it actually looked up.
+func (p *envProvider) envPrefix() string {
+    return p.prefix
+}
+
+// fillEnvFields populates a struct's exported fields (including nested
+// structs) from the process environment, using the default "env" tag name;
+// untagged fields are looked up under their snake_case+UPPER name. See
+// fillStruct for the full traversal rules.
+func fillEnvFields(structVal reflect.Value, prefix string) error {
+    return fillStruct(structVal, Options{TagName: "env", Prefix: prefix}, prefix, 0, map[reflect.Type]bool{})
+}
+
+// assignField resolves envKey's value (via os.Getenv, envDefault, and
+// optional envExpand) and, if present, converts and assigns it to fieldVal.
+// A missing value (required or not) is a no-op here: required fields are
+// validated once, after every provider in the pipeline has had a chance to
+// set them, by checkRequired.
+func assignField(fieldVal reflect.Value, field reflect.StructField, envKey string, unset bool) error {
+    envVal := os.Getenv(envKey)
+    if envVal == "" {
+        if def, ok := field.Tag.Lookup("envDefault"); ok {
+            envVal = def
+        }
+    }
+
+    if envVal == "" {
+        return nil
+    }
+
+    if expand, _ := strconv.ParseBool(field.Tag.Get("envExpand")); expand || ExpandAll {
+        expanded, err := expandValue(envVal, map[string]bool{envKey: true})
+        if err != nil {
+            return fmt.Errorf("%s: %w", envKey, err)
+        }
+        envVal = expanded
+    }
+
+    if !fieldVal.CanSet() {
+        return fmt.Errorf("cannot set field %s", field.Name)
+    }
+
+    if err := setFieldValue(fieldVal, field, envKey, envVal); err != nil {
+        return err
+    }
+
+    if unset {
+        os.Unsetenv(envKey)
+    }
+
+    return nil
+}
+
+// parseEnvTag splits an `env` struct tag into its environment variable key and
+// its comma-separated options, e.g. `env:"MONGO_HOST,required"`.
+func parseEnvTag(tag string) (key string, required, unset, secret bool) {
+    parts := strings.Split(tag, ",")
+    key = parts[0]
+    for _, opt := range parts[1:] {
+        switch strings.TrimSpace(opt) {
+        case "required":
+            required = true
+        case "unset":
+            unset = true
+        case "secret":
+            secret = true
+        }
+    }
+    return key, required, unset, secret
+}
+
+// setFieldValue converts envVal to fieldVal's type and assigns it, dispatching
+// to the slice/map helpers below for container kinds and recursing into their
+// element kinds so callers get an error naming both the env key and the
+// offending element.
+func setFieldValue(fieldVal reflect.Value, field reflect.StructField, envKey, envVal string) error {
+    switch {
+    case fieldVal.Type() == durationType:
+        d, err := time.ParseDuration(envVal)
+        if err != nil {
+            return fmt.Errorf("invalid duration value for %s: %v", envKey, err)
+        }
+        fieldVal.SetInt(int64(d))
+        return nil
+    case fieldVal.Kind() == reflect.Slice:
+        return setSliceValue(fieldVal, field, envKey, envVal)
+    case fieldVal.Kind() == reflect.Map:
+        return setMapValue(fieldVal, field, envKey, envVal)
+    }
+
+    switch fieldVal.Kind() {
+    case reflect.String:
+        fieldVal.SetString(envVal)
+    case reflect.Int, reflect.Int64:
+        intVal, err := strconv.Atoi(envVal)
+        if err != nil {
+            return fmt.Errorf("invalid integer value for %s: %v", envKey, err)
+        }
+        fieldVal.SetInt(int64(intVal))
+    case reflect.Bool:
+        boolVal, err := strconv.ParseBool(envVal)
+        if err != nil {
+            return fmt.Errorf("invalid boolean value for %s: %v", envKey, err)
+        }
+        fieldVal.SetBool(boolVal)
+    case reflect.Float64:
+        floatVal, err := strconv.ParseFloat(envVal, 64)
+        if err != nil {
+            return fmt.Errorf("invalid float value for %s: %v", envKey, err)
+        }
+        fieldVal.SetFloat(floatVal)
+    default:
+        return fmt.Errorf("unsupported field type for %s: %v", envKey, fieldVal.Kind())
+    }
+
+    return nil
+}
+
+// separators reads the envSeparator and envKeyValSeparator tags, falling back
+// to "," and ":" respectively.
+func separators(field reflect.StructField) (sep, kvSep string) {
+    sep = field.Tag.Get("envSeparator")
+    if sep == "" {
+        sep = ","
+    }
+    kvSep = field.Tag.Get("envKeyValSeparator")
+    if kvSep == "" {
+        kvSep = ":"
+    }
+    return sep, kvSep
+}
+
+// setSliceValue parses envVal as envSeparator-delimited elements into a new
+// slice of fieldVal's element kind. Supported element kinds: string, int,
+// int64, float64.
+func setSliceValue(fieldVal reflect.Value, field reflect.StructField, envKey, envVal string) error {
+    sep, _ := separators(field)
+    parts := strings.Split(envVal, sep)
+    elemType := fieldVal.Type().Elem()
+
+    slice := reflect.MakeSlice(fieldVal.Type(), len(parts), len(parts))
+    for i, part := range parts {
+        part = strings.TrimSpace(part)
+        elem := reflect.New(elemType).Elem()
+
+        switch elemType.Kind() {
+        case reflect.String:
+            elem.SetString(part)
+        case reflect.Int, reflect.Int64:
+            intVal, err := strconv.Atoi(part)
+            if err != nil {
+                return fmt.Errorf("invalid integer element %q for %s: %v", part, envKey, err)
+            }
+            elem.SetInt(int64(intVal))
+        case reflect.Float64:
+            floatVal, err := strconv.ParseFloat(part, 64)
+            if err != nil {
+                return fmt.Errorf("invalid float element %q for %s: %v", part, envKey, err)
+            }
+            elem.SetFloat(floatVal)
+        default:
+            return fmt.Errorf("unsupported slice element type for %s: %v", envKey, elemType.Kind())
+        }
+
+        slice.Index(i).Set(elem)
+    }
+
+    fieldVal.Set(slice)
+    return nil
+}
+
+// setMapValue parses envVal as envSeparator-delimited "key<envKeyValSeparator>value"
+// pairs (e.g. "red:1,green:2,blue:3") into a new map of fieldVal's type. Only
+// string keys are supported; values may be string, int, int64, or float64.
+func setMapValue(fieldVal reflect.Value, field reflect.StructField, envKey, envVal string) error {
+    sep, kvSep := separators(field)
+    mapType := fieldVal.Type()
+    if mapType.Key().Kind() != reflect.String {
+        return fmt.Errorf("unsupported map key type for %s: %v", envKey, mapType.Key().Kind())
+    }
+
+    result := reflect.MakeMap(mapType)
+    for _, pair := range strings.Split(envVal, sep) {
+        kv := strings.SplitN(pair, kvSep, 2)
+        if len(kv) != 2 {
+            return fmt.Errorf("invalid key%svalue pair %q for %s", kvSep, pair, envKey)
+        }
+
+        key := strings.TrimSpace(kv[0])
+        valStr := strings.TrimSpace(kv[1])
+        elem := reflect.New(mapType.Elem()).Elem()
+
+        switch mapType.Elem().Kind() {
+        case reflect.String:
+            elem.SetString(valStr)
+        case reflect.Int, reflect.Int64:
+            intVal, err := strconv.Atoi(valStr)
+            if err != nil {
+                return fmt.Errorf("invalid integer value %q for %s: %v", valStr, envKey, err)
+            }
+            elem.SetInt(int64(intVal))
+        case reflect.Float64:
+            floatVal, err := strconv.ParseFloat(valStr, 64)
+            if err != nil {
+                return fmt.Errorf("invalid float value %q for %s: %v", valStr, envKey, err)
+            }
+            elem.SetFloat(floatVal)
+        default:
+            return fmt.Errorf("unsupported map value type for %s: %v", envKey, mapType.Elem().Kind())
+        }
+
+        result.SetMapIndex(reflect.ValueOf(key), elem)
+    }
+
+    fieldVal.Set(result)
+    return nil
+}
@@ -0,0 +1,133 @@
+package config
+
+import (
+    "errors"
+    "os"
+    "strings"
+    "testing"
+    "time"
+)
+
+func TestFromEnv_DefaultsAndUnset(t *testing.T) {
+    type cfg struct {
+        Host   string `env:"TEST_HOST,required"`
+        Port   int    `env:"TEST_PORT" envDefault:"8080"`
+        Secret string `env:"TEST_SECRET,unset"`
+    }
+
+    t.Setenv("TEST_HOST", "example.com")
+    t.Setenv("TEST_SECRET", "shh")
+
+    var c cfg
+    if err := Load(&c, FromEnv()); err != nil {
+        t.Fatalf("Load returned unexpected error: %v", err)
+    }
+
+    if c.Host != "example.com" {
+        t.Errorf("Host = %q, want %q", c.Host, "example.com")
+    }
+    if c.Port != 8080 {
+        t.Errorf("Port = %d, want 8080 (from envDefault)", c.Port)
+    }
+    if c.Secret != "shh" {
+        t.Errorf("Secret = %q, want %q", c.Secret, "shh")
+    }
+    if v := os.Getenv("TEST_SECRET"); v != "" {
+        t.Errorf("TEST_SECRET still set after unset: %q", v)
+    }
+}
+
+func TestFromEnv_RequiredErrorsAreAggregated(t *testing.T) {
+    type cfg struct {
+        A string `env:"TEST_MISSING_A,required"`
+        B string `env:"TEST_MISSING_B,required"`
+    }
+
+    var c cfg
+    err := Load(&c, FromEnv())
+    if err == nil {
+        t.Fatal("expected an error for two missing required fields, got nil")
+    }
+
+    var joined interface{ Unwrap() []error }
+    if !errors.As(err, &joined) {
+        t.Fatalf("error does not implement Unwrap() []error: %v", err)
+    }
+
+    msg := err.Error()
+    for _, key := range []string{"TEST_MISSING_A", "TEST_MISSING_B"} {
+        if !strings.Contains(msg, key) {
+            t.Errorf("expected aggregated error to mention %s, got: %v", key, err)
+        }
+    }
+}
+
+func TestFromEnv_SlicesMapsAndDuration(t *testing.T) {
+    type cfg struct {
+        Users       []string       `env:"TEST_USERS" envSeparator:","`
+        Ports       []int          `env:"TEST_PORTS" envSeparator:";"`
+        ColorCodes  map[string]int `env:"TEST_COLOR_CODES"`
+        Timeout     time.Duration  `env:"TEST_TIMEOUT"`
+    }
+
+    t.Setenv("TEST_USERS", "alice, bob,carol")
+    t.Setenv("TEST_PORTS", "80;443;8080")
+    t.Setenv("TEST_COLOR_CODES", "red:1,green:2,blue:3")
+    t.Setenv("TEST_TIMEOUT", "3m")
+
+    var c cfg
+    if err := Load(&c, FromEnv()); err != nil {
+        t.Fatalf("Load returned unexpected error: %v", err)
+    }
+
+    wantUsers := []string{"alice", "bob", "carol"}
+    if len(c.Users) != len(wantUsers) {
+        t.Fatalf("Users = %v, want %v", c.Users, wantUsers)
+    }
+    for i, u := range wantUsers {
+        if c.Users[i] != u {
+            t.Errorf("Users[%d] = %q, want %q", i, c.Users[i], u)
+        }
+    }
+
+    wantPorts := []int{80, 443, 8080}
+    if len(c.Ports) != len(wantPorts) {
+        t.Fatalf("Ports = %v, want %v", c.Ports, wantPorts)
+    }
+    for i, p := range wantPorts {
+        if c.Ports[i] != p {
+            t.Errorf("Ports[%d] = %d, want %d", i, c.Ports[i], p)
+        }
+    }
+
+    wantColors := map[string]int{"red": 1, "green": 2, "blue": 3}
+    if len(c.ColorCodes) != len(wantColors) {
+        t.Fatalf("ColorCodes = %v, want %v", c.ColorCodes, wantColors)
+    }
+    for k, v := range wantColors {
+        if c.ColorCodes[k] != v {
+            t.Errorf("ColorCodes[%q] = %d, want %d", k, c.ColorCodes[k], v)
+        }
+    }
+
+    if c.Timeout != 3*time.Minute {
+        t.Errorf("Timeout = %v, want %v", c.Timeout, 3*time.Minute)
+    }
+}
+
+func TestFromEnv_InvalidSliceElementNamesKeyAndElement(t *testing.T) {
+    type cfg struct {
+        Ports []int `env:"TEST_BAD_PORTS"`
+    }
+
+    t.Setenv("TEST_BAD_PORTS", "80,not-a-port")
+
+    var c cfg
+    err := Load(&c, FromEnv())
+    if err == nil {
+        t.Fatal("expected an error for a non-integer slice element, got nil")
+    }
+    if !strings.Contains(err.Error(), "TEST_BAD_PORTS") || !strings.Contains(err.Error(), "not-a-port") {
+        t.Errorf("expected error to name both the env key and the offending element, got: %v", err)
+    }
+}
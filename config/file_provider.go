@@ -0,0 +1,59 @@
+package config
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "os"
+    "path/filepath"
+    "reflect"
+    "strings"
+
+    "github.com/BurntSushi/toml"
+    "gopkg.in/yaml.v3"
+)
+
+// fileProvider is the Provider returned by FromFile.
+type fileProvider struct {
+    path string
+}
+
+// FromFile returns a Provider that reads path and unmarshals it into the
+// config struct, dispatching on the file extension: .json, .yaml/.yml, or
+// .toml. Fields are matched using that format's own struct tags (`json`,
+// `yaml`, `toml`), so a single Config struct can carry env, json, yaml, toml,
+// and flag tags side by side.
+func FromFile(path string) Provider {
+    return &fileProvider{path: path}
+}
+
+func (p *fileProvider) Fill(ctx context.Context, structVal reflect.Value) error {
+    data, err := os.ReadFile(p.path)
+    if err != nil {
+        return fmt.Errorf("reading config file %s: %w", p.path, err)
+    }
+
+    if !structVal.CanAddr() {
+        return fmt.Errorf("config must be addressable to load %s", p.path)
+    }
+    dest := structVal.Addr().Interface()
+
+    switch ext := strings.ToLower(filepath.Ext(p.path)); ext {
+    case ".json":
+        if err := json.Unmarshal(data, dest); err != nil {
+            return fmt.Errorf("parsing %s as JSON: %w", p.path, err)
+        }
+    case ".yaml", ".yml":
+        if err := yaml.Unmarshal(data, dest); err != nil {
+            return fmt.Errorf("parsing %s as YAML: %w", p.path, err)
+        }
+    case ".toml":
+        if err := toml.Unmarshal(data, dest); err != nil {
+            return fmt.Errorf("parsing %s as TOML: %w", p.path, err)
+        }
+    default:
+        return fmt.Errorf("unsupported config file extension %q for %s", ext, p.path)
+    }
+
+    return nil
+}
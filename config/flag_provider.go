@@ -0,0 +1,159 @@
+package config
+
+import (
+    "context"
+    "errors"
+    "flag"
+    "fmt"
+    "os"
+    "reflect"
+    "strconv"
+    "strings"
+)
+
+// flagProvider is the Provider returned by FromFlags.
+type flagProvider struct {
+    args []string
+}
+
+// flagBinding ties a tagged struct field to the flag value parsed for it.
+type flagBinding struct {
+    fieldVal reflect.Value
+    kind     reflect.Kind
+}
+
+// FlagOption configures a Provider returned by FromFlags.
+type FlagOption func(*flagProvider)
+
+// WithArgs overrides the argument slice FromFlags parses, instead of the
+// default os.Args[1:]. Use this when the host program needs to hand FromFlags
+// a slice it has already separated from its own flags (or, in tests, from
+// os.Args' test binary flags).
+func WithArgs(args []string) FlagOption {
+    return func(p *flagProvider) { p.args = args }
+}
+
+// FromFlags returns a Provider that populates struct fields tagged `flag`
+// from command-line flags, e.g. a field tagged `flag:"debug"` is set by
+// -debug=true. Only flags the user actually passed override the struct's
+// current value, so FromFlags composes with earlier providers in a pipeline
+// instead of resetting every field to its zero value. Flags that aren't
+// bound to a tagged field are ignored rather than rejected, so FromFlags
+// composes with a host program's own flags (and with go test's) instead of
+// assuming it owns the entire argument list. Pass WithArgs to parse a
+// specific slice instead of os.Args[1:].
+func FromFlags(opts ...FlagOption) Provider {
+    p := &flagProvider{args: os.Args[1:]}
+    for _, opt := range opts {
+        opt(p)
+    }
+    return p
+}
+
+func (p *flagProvider) Fill(ctx context.Context, structVal reflect.Value) error {
+    fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+    structType := structVal.Type()
+
+    bindings := make(map[string]flagBinding)
+
+    for i := 0; i < structType.NumField(); i++ {
+        field := structType.Field(i)
+        flagName, ok := field.Tag.Lookup("flag")
+        if !ok {
+            continue
+        }
+
+        fieldVal := structVal.Field(i)
+        switch fieldVal.Kind() {
+        case reflect.String:
+            fs.String(flagName, fieldVal.String(), "")
+        case reflect.Int, reflect.Int64:
+            fs.Int64(flagName, fieldVal.Int(), "")
+        case reflect.Bool:
+            fs.Bool(flagName, fieldVal.Bool(), "")
+        case reflect.Float64:
+            fs.Float64(flagName, fieldVal.Float(), "")
+        default:
+            return fmt.Errorf("unsupported flag field type for %s: %v", flagName, fieldVal.Kind())
+        }
+
+        bindings[flagName] = flagBinding{fieldVal: fieldVal, kind: fieldVal.Kind()}
+    }
+
+    if err := fs.Parse(recognizedArgs(p.args, bindings)); err != nil {
+        return fmt.Errorf("parsing flags: %w", err)
+    }
+
+    var errs []error
+    fs.Visit(func(f *flag.Flag) {
+        b, ok := bindings[f.Name]
+        if !ok {
+            return
+        }
+
+        switch b.kind {
+        case reflect.String:
+            b.fieldVal.SetString(f.Value.String())
+        case reflect.Int, reflect.Int64:
+            intVal, err := strconv.ParseInt(f.Value.String(), 10, 64)
+            if err != nil {
+                errs = append(errs, fmt.Errorf("invalid value for -%s: %v", f.Name, err))
+                return
+            }
+            b.fieldVal.SetInt(intVal)
+        case reflect.Bool:
+            boolVal, err := strconv.ParseBool(f.Value.String())
+            if err != nil {
+                errs = append(errs, fmt.Errorf("invalid value for -%s: %v", f.Name, err))
+                return
+            }
+            b.fieldVal.SetBool(boolVal)
+        case reflect.Float64:
+            floatVal, err := strconv.ParseFloat(f.Value.String(), 64)
+            if err != nil {
+                errs = append(errs, fmt.Errorf("invalid value for -%s: %v", f.Name, err))
+                return
+            }
+            b.fieldVal.SetFloat(floatVal)
+        }
+    })
+
+    return errors.Join(errs...)
+}
+
+// recognizedArgs returns the subset of args that refer to a flag bound in
+// bindings, dropping everything else (including each dropped flag's value
+// token, if it has one) so that an unrelated flag defined by the host program
+// or injected by `go test` doesn't make fs.Parse fail with "flag provided but
+// not defined".
+func recognizedArgs(args []string, bindings map[string]flagBinding) []string {
+    var out []string
+
+    for i := 0; i < len(args); i++ {
+        arg := args[i]
+        if arg == "--" {
+            break
+        }
+
+        if !strings.HasPrefix(arg, "-") {
+            continue
+        }
+
+        name, _, hasInlineValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+        b, ok := bindings[name]
+        if !ok {
+            if !hasInlineValue && i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+                i++
+            }
+            continue
+        }
+
+        out = append(out, arg)
+        if !hasInlineValue && b.kind != reflect.Bool && i+1 < len(args) {
+            i++
+            out = append(out, args[i])
+        }
+    }
+
+    return out
+}
@@ -0,0 +1,48 @@
+package config
+
+import "testing"
+
+func TestFromFlags_SetsTaggedFields(t *testing.T) {
+    type cfg struct {
+        Host    string `flag:"host"`
+        Verbose bool   `flag:"verbose"`
+    }
+
+    var c cfg
+    if err := Load(&c, FromFlags(WithArgs([]string{"-host=foo", "-verbose"}))); err != nil {
+        t.Fatalf("Load returned unexpected error: %v", err)
+    }
+
+    if c.Host != "foo" {
+        t.Errorf("Host = %q, want %q", c.Host, "foo")
+    }
+    if !c.Verbose {
+        t.Error("Verbose = false, want true")
+    }
+}
+
+func TestFromFlags_IgnoresFlagsNotBoundToATaggedField(t *testing.T) {
+    type cfg struct {
+        Host string `flag:"host"`
+    }
+
+    var c cfg
+    err := Load(&c, FromFlags(WithArgs([]string{"-verbose", "-host=foo", "-other=bar"})))
+    if err != nil {
+        t.Fatalf("Load returned unexpected error for an unrecognized flag: %v", err)
+    }
+    if c.Host != "foo" {
+        t.Errorf("Host = %q, want %q", c.Host, "foo")
+    }
+}
+
+func TestFromFlags_DefaultArgsDoNotErrorUnderGoTest(t *testing.T) {
+    type cfg struct {
+        Host string `flag:"host"`
+    }
+
+    var c cfg
+    if err := Load(&c, FromFlags()); err != nil {
+        t.Fatalf("FromFlags with default os.Args errored under go test: %v", err)
+    }
+}
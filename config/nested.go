@@ -0,0 +1,189 @@
+package config
+
+import (
+    "errors"
+    "fmt"
+    "reflect"
+    "strings"
+    "unicode"
+)
+
+// maxDepth bounds how many levels of nested structs fillStruct will recurse
+// into, guarding against runaway recursion on deeply or self nested configs.
+const maxDepth = 16
+
+// Options configures ParseWithOptions' traversal of a config struct.
+type Options struct {
+    // Prefix is prepended to every derived environment variable name.
+    Prefix string
+    // TagName overrides the struct tag used to look up explicit env keys.
+    // Defaults to "env".
+    TagName string
+}
+
+// ParseWithOptions populates cfg from the process environment like FromEnv,
+// but lets callers override the tag name and prefix, and auto-derives
+// variable names (MongoDBHost -> MONGO_DB_HOST) for fields with no explicit
+// tag. Nested and embedded structs are traversed recursively, prefixed by
+// their envPrefix tag or their own derived name.
+func ParseWithOptions[T any](cfg *T, opts Options) error {
+    val := reflect.ValueOf(cfg)
+    if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+        return fmt.Errorf("config must be a pointer to a struct")
+    }
+
+    if opts.TagName == "" {
+        opts.TagName = "env"
+    }
+
+    fillErr := fillStruct(val.Elem(), opts, opts.Prefix, 0, map[reflect.Type]bool{})
+    requiredErr := checkRequired(val.Elem(), opts.TagName, opts.Prefix)
+
+    return errors.Join(fillErr, requiredErr)
+}
+
+// fillStruct walks structVal's fields, recursing into nested or embedded
+// structs (and pointers to structs) with an accumulated prefix, and assigning
+// leaf fields from the environment. visited guards against cycles created by
+// pointer-to-struct fields referencing an ancestor's type.
+func fillStruct(structVal reflect.Value, opts Options, prefix string, depth int, visited map[reflect.Type]bool) error {
+    if depth > maxDepth {
+        return fmt.Errorf("exceeded max nesting depth (%d) while parsing config", maxDepth)
+    }
+
+    structType := structVal.Type()
+    var errs []error
+
+    for i := 0; i < structType.NumField(); i++ {
+        field := structType.Field(i)
+        fieldVal := structVal.Field(i)
+
+        if !fieldVal.CanSet() {
+            continue
+        }
+
+        switch {
+        case fieldVal.Kind() == reflect.Struct:
+            if err := fillNested(fieldVal, field, opts, prefix, depth, visited); err != nil {
+                errs = append(errs, err)
+            }
+
+        case fieldVal.Kind() == reflect.Ptr && fieldVal.Type().Elem().Kind() == reflect.Struct:
+            if fieldVal.IsNil() {
+                fieldVal.Set(reflect.New(fieldVal.Type().Elem()))
+            }
+            if err := fillNested(fieldVal.Elem(), field, opts, prefix, depth, visited); err != nil {
+                errs = append(errs, err)
+            }
+
+        default:
+            envTag, ok := field.Tag.Lookup(opts.TagName)
+            var key string
+            var unset bool
+            if ok {
+                key, _, unset, _ = parseEnvTag(envTag)
+                key = prefix + key
+            } else {
+                key = prefix + toSnakeUpper(field.Name)
+            }
+
+            if err := assignField(fieldVal, field, key, unset); err != nil {
+                errs = append(errs, err)
+            }
+        }
+    }
+
+    return errors.Join(errs...)
+}
+
+// fillNested recurses fillStruct into a nested struct field, guarding against
+// cycles and deriving its prefix from an envPrefix tag or its own name.
+func fillNested(nestedVal reflect.Value, field reflect.StructField, opts Options, prefix string, depth int, visited map[reflect.Type]bool) error {
+    nestedType := nestedVal.Type()
+    if visited[nestedType] {
+        return fmt.Errorf("cycle detected on type %s", nestedType)
+    }
+
+    visited[nestedType] = true
+    defer delete(visited, nestedType)
+
+    nestedPrefix := prefix + nestedPrefixFor(field)
+    return fillStruct(nestedVal, opts, nestedPrefix, depth+1, visited)
+}
+
+// nestedPrefixFor derives the prefix a nested struct field's own fields are
+// resolved under: its explicit envPrefix tag, or its snake_case+UPPER name
+// followed by an underscore.
+func nestedPrefixFor(field reflect.StructField) string {
+    if p, ok := field.Tag.Lookup("envPrefix"); ok {
+        return p
+    }
+    return toSnakeUpper(field.Name) + "_"
+}
+
+// checkRequired walks structVal the same way fillStruct does, verifying that
+// every field whose tagName tag carries the "required" option ended up with
+// a non-zero value. It runs once, after every provider in a pipeline has had
+// a chance to populate the struct, so a required field satisfied by e.g.
+// FromFile doesn't fail just because a later FromEnv found nothing to set.
+func checkRequired(structVal reflect.Value, tagName, prefix string) error {
+    structType := structVal.Type()
+    var errs []error
+
+    for i := 0; i < structType.NumField(); i++ {
+        field := structType.Field(i)
+        fieldVal := structVal.Field(i)
+
+        switch {
+        case fieldVal.Kind() == reflect.Struct:
+            if err := checkRequired(fieldVal, tagName, prefix+nestedPrefixFor(field)); err != nil {
+                errs = append(errs, err)
+            }
+
+        case fieldVal.Kind() == reflect.Ptr && fieldVal.Type().Elem().Kind() == reflect.Struct:
+            if !fieldVal.IsNil() {
+                if err := checkRequired(fieldVal.Elem(), tagName, prefix+nestedPrefixFor(field)); err != nil {
+                    errs = append(errs, err)
+                }
+            }
+
+        default:
+            envTag, ok := field.Tag.Lookup(tagName)
+            if !ok {
+                continue
+            }
+
+            key, required, _, _ := parseEnvTag(envTag)
+            if !required {
+                continue
+            }
+
+            if fieldVal.IsZero() {
+                errs = append(errs, fmt.Errorf("%s: required field is not set", prefix+key))
+            }
+        }
+    }
+
+    return errors.Join(errs...)
+}
+
+// toSnakeUpper converts a Go identifier such as MongoDBHost into its
+// SCREAMING_SNAKE_CASE environment variable form, e.g. MONGO_DB_HOST,
+// treating runs of consecutive uppercase letters (acronyms) as one word.
+func toSnakeUpper(name string) string {
+    runes := []rune(name)
+    var sb strings.Builder
+
+    for i, r := range runes {
+        if i > 0 && unicode.IsUpper(r) {
+            prev := runes[i-1]
+            nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+            if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+                sb.WriteByte('_')
+            }
+        }
+        sb.WriteRune(unicode.ToUpper(r))
+    }
+
+    return sb.String()
+}
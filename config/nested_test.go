@@ -0,0 +1,60 @@
+package config
+
+import "testing"
+
+func TestParseWithOptions_NestedPrefixAndAutoNaming(t *testing.T) {
+    type DB struct {
+        Host string
+        Port int `env:"PORT" envDefault:"5432"`
+    }
+    type cfg struct {
+        DB          DB `envPrefix:"DB_"`
+        MongoDBHost string
+    }
+
+    t.Setenv("DB_HOST", "db.example.com")
+    t.Setenv("MONGO_DB_HOST", "mongo.example.com")
+
+    var c cfg
+    if err := ParseWithOptions(&c, Options{}); err != nil {
+        t.Fatalf("ParseWithOptions returned unexpected error: %v", err)
+    }
+
+    if c.DB.Host != "db.example.com" {
+        t.Errorf("DB.Host = %q, want %q", c.DB.Host, "db.example.com")
+    }
+    if c.DB.Port != 5432 {
+        t.Errorf("DB.Port = %d, want 5432 (from envDefault)", c.DB.Port)
+    }
+    if c.MongoDBHost != "mongo.example.com" {
+        t.Errorf("MongoDBHost = %q, want %q (auto snake_case name)", c.MongoDBHost, "mongo.example.com")
+    }
+}
+
+func TestParseWithOptions_Prefix(t *testing.T) {
+    type cfg struct {
+        Host string `env:"HOST"`
+    }
+
+    t.Setenv("APP_HOST", "app.example.com")
+
+    var c cfg
+    if err := ParseWithOptions(&c, Options{Prefix: "APP_"}); err != nil {
+        t.Fatalf("ParseWithOptions returned unexpected error: %v", err)
+    }
+    if c.Host != "app.example.com" {
+        t.Errorf("Host = %q, want %q", c.Host, "app.example.com")
+    }
+}
+
+func TestParseWithOptions_CyclicPointerIsRejected(t *testing.T) {
+    type Node struct {
+        Name string
+        Next *Node
+    }
+
+    var n Node
+    if err := ParseWithOptions(&n, Options{}); err == nil {
+        t.Fatal("expected a cycle-detection error for a self-referential pointer field, got nil")
+    }
+}
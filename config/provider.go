@@ -0,0 +1,67 @@
+package config
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "reflect"
+)
+
+// Provider supplies configuration values by filling the fields of a struct
+// reached via reflection. Providers are applied in the order passed to Load,
+// and each one may override any field a provider before it already set.
+type Provider interface {
+    Fill(ctx context.Context, structVal reflect.Value) error
+}
+
+// envPrefixer is implemented by providers that resolve struct fields through
+// a prefixed "env" tag (currently just envProvider), so Load can report
+// required-field errors using the key that was actually looked up instead of
+// the bare tag key.
+type envPrefixer interface {
+    envPrefix() string
+}
+
+// Load populates config by applying each Provider in turn, so that later
+// providers override fields set by earlier ones. A typical pipeline layers a
+// file, a .env file, the OS environment, and command-line flags:
+//
+//	config.Load(&cfg,
+//	    config.FromFile("config.yaml"),
+//	    config.FromDotEnv("../.env"),
+//	    config.FromEnv(config.WithPrefix("APP_")),
+//	    config.FromFlags(),
+//	)
+//
+// Errors from every provider are collected and returned together via
+// errors.Join rather than aborting at the first failing provider. Fields
+// tagged `env:"...,required"` are validated once, after every provider has
+// run, so a required field satisfied by an earlier provider (e.g. FromFile)
+// is not wrongly flagged as missing just because a later one (e.g. FromEnv)
+// found nothing to set.
+func Load[T any](config *T, providers ...Provider) error {
+    val := reflect.ValueOf(config)
+    if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+        return fmt.Errorf("config must be a pointer to a struct")
+    }
+
+    ctx := context.Background()
+    structVal := val.Elem()
+
+    var errs []error
+    var prefix string
+    for _, p := range providers {
+        if pfx, ok := p.(envPrefixer); ok {
+            prefix = pfx.envPrefix()
+        }
+        if err := p.Fill(ctx, structVal); err != nil {
+            errs = append(errs, err)
+        }
+    }
+
+    if err := checkRequired(structVal, "env", prefix); err != nil {
+        errs = append(errs, err)
+    }
+
+    return errors.Join(errs...)
+}
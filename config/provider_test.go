@@ -0,0 +1,112 @@
+package config
+
+import (
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func writeFile(t *testing.T, name, contents string) string {
+    t.Helper()
+    path := filepath.Join(t.TempDir(), name)
+    if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+        t.Fatalf("writing test file %s: %v", name, err)
+    }
+    return path
+}
+
+func TestLoad_LaterProvidersOverrideEarlierOnes(t *testing.T) {
+    path := writeFile(t, "config.json", `{"host":"file-host","port":1111}`)
+
+    type cfg struct {
+        Host string `json:"host" env:"TEST_OVERRIDE_HOST"`
+        Port int    `json:"port" env:"TEST_OVERRIDE_PORT"`
+    }
+
+    t.Setenv("TEST_OVERRIDE_PORT", "2222")
+
+    var c cfg
+    if err := Load(&c, FromFile(path), FromEnv()); err != nil {
+        t.Fatalf("Load returned unexpected error: %v", err)
+    }
+
+    if c.Host != "file-host" {
+        t.Errorf("Host = %q, want %q (untouched by env)", c.Host, "file-host")
+    }
+    if c.Port != 2222 {
+        t.Errorf("Port = %d, want 2222 (overridden by env)", c.Port)
+    }
+}
+
+func TestLoad_RequiredFieldSatisfiedByEarlierProviderPasses(t *testing.T) {
+    path := writeFile(t, "config.json", `{"host":"file-host"}`)
+
+    type cfg struct {
+        Host string `json:"host" env:"TEST_REQUIRED_HOST,required"`
+    }
+
+    var c cfg
+    if err := Load(&c, FromFile(path), FromEnv()); err != nil {
+        t.Fatalf("Load returned unexpected error for a required field set by FromFile: %v", err)
+    }
+    if c.Host != "file-host" {
+        t.Errorf("Host = %q, want %q", c.Host, "file-host")
+    }
+}
+
+func TestLoad_RequiredFieldMissingFromEveryProviderFails(t *testing.T) {
+    type cfg struct {
+        Host string `env:"TEST_REQUIRED_MISSING,required"`
+    }
+
+    var c cfg
+    if err := Load(&c, FromEnv()); err == nil {
+        t.Fatal("expected an error when no provider sets a required field")
+    }
+}
+
+func TestLoad_RequiredFieldErrorNamesThePrefixedKey(t *testing.T) {
+    type cfg struct {
+        Host string `env:"HOST,required"`
+    }
+
+    var c cfg
+    err := Load(&c, FromEnv(WithPrefix("APP_")))
+    if err == nil {
+        t.Fatal("expected an error for a missing required field")
+    }
+    if !strings.Contains(err.Error(), "APP_HOST") {
+        t.Errorf("expected error to name the prefixed env var APP_HOST, got: %v", err)
+    }
+}
+
+func TestFromFile_UnsupportedExtension(t *testing.T) {
+    path := writeFile(t, "config.ini", "host=file-host")
+
+    type cfg struct {
+        Host string `env:"TEST_INI_HOST"`
+    }
+
+    var c cfg
+    if err := Load(&c, FromFile(path)); err == nil {
+        t.Fatal("expected an error for an unsupported file extension")
+    }
+}
+
+func TestFromFile_YAML(t *testing.T) {
+    path := writeFile(t, "config.yaml", "host: yaml-host\nport: 3333\n")
+
+    type cfg struct {
+        Host string `yaml:"host"`
+        Port int    `yaml:"port"`
+    }
+
+    var c cfg
+    if err := Load(&c, FromFile(path)); err != nil {
+        t.Fatalf("Load returned unexpected error: %v", err)
+    }
+    if c.Host != "yaml-host" || c.Port != 3333 {
+        t.Errorf("got %+v, want Host=yaml-host Port=3333", c)
+    }
+}
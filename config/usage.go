@@ -0,0 +1,139 @@
+package config
+
+import (
+    "encoding/json"
+    "fmt"
+    "io"
+    "reflect"
+    "text/tabwriter"
+)
+
+// UsageFormat selects how Usage renders the environment variable table.
+type UsageFormat int
+
+const (
+    // UsageText renders an aligned plain-text table.
+    UsageText UsageFormat = iota
+    // UsageJSON renders a machine-readable JSON array.
+    UsageJSON
+)
+
+// usageEntry describes one environment variable surfaced by Usage.
+type usageEntry struct {
+    Key         string `json:"key"`
+    Type        string `json:"type"`
+    Default     string `json:"default,omitempty"`
+    Required    bool   `json:"required"`
+    Value       string `json:"value,omitempty"`
+    Description string `json:"description,omitempty"`
+}
+
+// Usage reflects over cfg and writes a table of every exported field
+// (including nested structs, and untagged fields under their derived
+// snake_case+UPPER key) to w: its key, type, envDefault, required flag,
+// desc tag, and currently resolved value. Fields whose env tag includes the
+// "secret" option have their value masked, so operators can run something
+// like "myapp -help-env" to see every knob and still get a safe config dump
+// for debugging. format selects plain-text tabular or JSON output.
+func Usage[T any](cfg *T, w io.Writer, format UsageFormat) error {
+    val := reflect.ValueOf(cfg)
+    if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+        return fmt.Errorf("config must be a pointer to a struct")
+    }
+
+    entries := collectUsage(val.Elem(), "", 0, map[reflect.Type]bool{})
+
+    if format == UsageJSON {
+        enc := json.NewEncoder(w)
+        enc.SetIndent("", "  ")
+        return enc.Encode(entries)
+    }
+
+    tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+    fmt.Fprintln(tw, "KEY\tTYPE\tDEFAULT\tREQUIRED\tVALUE\tDESCRIPTION")
+    for _, e := range entries {
+        fmt.Fprintf(tw, "%s\t%s\t%s\t%t\t%s\t%s\n", e.Key, e.Type, e.Default, e.Required, e.Value, e.Description)
+    }
+    return tw.Flush()
+}
+
+// collectUsage walks structVal the same way fillStruct does, deriving each
+// field's env key from its tag or its auto snake_case name (falling back to
+// toSnakeUpper when no tag is present) so the table matches exactly what
+// FromEnv would read. depth and visited bound and cycle-guard the recursion
+// into nested/pointer struct fields the same way fillStruct does.
+func collectUsage(structVal reflect.Value, prefix string, depth int, visited map[reflect.Type]bool) []usageEntry {
+    if depth > maxDepth {
+        return nil
+    }
+
+    var entries []usageEntry
+    structType := structVal.Type()
+
+    for i := 0; i < structType.NumField(); i++ {
+        field := structType.Field(i)
+        fieldVal := structVal.Field(i)
+
+        if !fieldVal.CanSet() {
+            continue
+        }
+
+        switch {
+        case fieldVal.Kind() == reflect.Struct:
+            entries = append(entries, collectNested(fieldVal, field, prefix, depth, visited)...)
+            continue
+
+        case fieldVal.Kind() == reflect.Ptr && fieldVal.Type().Elem().Kind() == reflect.Struct:
+            nestedVal := fieldVal
+            if fieldVal.IsNil() {
+                nestedVal = reflect.New(fieldVal.Type().Elem())
+            }
+            entries = append(entries, collectNested(nestedVal.Elem(), field, prefix, depth, visited)...)
+            continue
+        }
+
+        envTag, ok := field.Tag.Lookup("env")
+        var key string
+        var required, secret bool
+        if ok {
+            key, required, _, secret = parseEnvTag(envTag)
+            key = prefix + key
+        } else {
+            key = prefix + toSnakeUpper(field.Name)
+        }
+
+        entry := usageEntry{
+            Key:         key,
+            Type:        fieldVal.Type().String(),
+            Default:     field.Tag.Get("envDefault"),
+            Required:    required,
+            Description: field.Tag.Get("desc"),
+        }
+
+        if !fieldVal.IsZero() {
+            if secret {
+                entry.Value = "*****"
+            } else {
+                entry.Value = fmt.Sprintf("%v", fieldVal.Interface())
+            }
+        }
+
+        entries = append(entries, entry)
+    }
+
+    return entries
+}
+
+// collectNested recurses collectUsage into a nested struct field, guarding
+// against cycles the same way fillNested does for fillStruct.
+func collectNested(nestedVal reflect.Value, field reflect.StructField, prefix string, depth int, visited map[reflect.Type]bool) []usageEntry {
+    nestedType := nestedVal.Type()
+    if visited[nestedType] {
+        return nil
+    }
+
+    visited[nestedType] = true
+    defer delete(visited, nestedType)
+
+    return collectUsage(nestedVal, prefix+nestedPrefixFor(field), depth+1, visited)
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+    "bytes"
+    "encoding/json"
+    "strings"
+    "testing"
+    "time"
+)
+
+func TestUsage_TextTable(t *testing.T) {
+    type cfg struct {
+        Host     string `env:"HOST,required" desc:"server host"`
+        Port     int    `env:"PORT" envDefault:"8080"`
+        APIKey   string `env:"API_KEY,secret"`
+        Untagged string
+    }
+
+    c := cfg{Host: "example.com", Port: 8080, APIKey: "topsecret", Untagged: "plain"}
+
+    var buf bytes.Buffer
+    if err := Usage(&c, &buf, UsageText); err != nil {
+        t.Fatalf("Usage returned unexpected error: %v", err)
+    }
+
+    out := buf.String()
+    for _, want := range []string{"HOST", "example.com", "PORT", "8080", "API_KEY", "*****", "UNTAGGED", "plain"} {
+        if !strings.Contains(out, want) {
+            t.Errorf("usage table missing %q:\n%s", want, out)
+        }
+    }
+    if strings.Contains(out, "topsecret") {
+        t.Errorf("usage table leaked the secret value:\n%s", out)
+    }
+}
+
+func TestUsage_JSON(t *testing.T) {
+    type cfg struct {
+        Host string `env:"HOST" envDefault:"example.com" desc:"server host"`
+    }
+
+    c := cfg{Host: "example.com"}
+
+    var buf bytes.Buffer
+    if err := Usage(&c, &buf, UsageJSON); err != nil {
+        t.Fatalf("Usage returned unexpected error: %v", err)
+    }
+
+    var entries []map[string]interface{}
+    if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+        t.Fatalf("Usage JSON output did not parse: %v\n%s", err, buf.String())
+    }
+    if len(entries) != 1 || entries[0]["key"] != "HOST" {
+        t.Errorf("unexpected JSON entries: %v", entries)
+    }
+}
+
+func TestUsage_CyclicPointerDoesNotHang(t *testing.T) {
+    type Node struct {
+        Name string
+        Next *Node
+    }
+
+    n := Node{Name: "root"}
+
+    done := make(chan error, 1)
+    go func() {
+        var buf bytes.Buffer
+        done <- Usage(&n, &buf, UsageText)
+    }()
+
+    select {
+    case err := <-done:
+        if err != nil {
+            t.Fatalf("Usage returned unexpected error: %v", err)
+        }
+    case <-time.After(2 * time.Second):
+        t.Fatal("Usage did not return for a self-referential pointer field; recursion guard missing")
+    }
+}